@@ -0,0 +1,126 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncs
+
+import "math"
+
+// WCAG 2.x contrast ratio thresholds.
+const (
+	aaNormalText  = 4.5
+	aaLargeText   = 3.0
+	aaaNormalText = 7.0
+	aaaLargeText  = 4.5
+)
+
+// RelativeLuminance returns the WCAG 2.x relative luminance of c, in
+// [0, 1]. See https://www.w3.org/TR/WCAG21/#dfn-relative-luminance.
+func RelativeLuminance(c Color) float64 {
+	r, g, b, _ := c.RGBA()
+	lin := func(v uint32) float64 {
+		s := float64(v>>8) / 255
+		if s <= 0.03928 {
+			return s / 12.92
+		}
+		return math.Pow((s+0.055)/1.055, 2.4)
+	}
+	return 0.2126*lin(r) + 0.7152*lin(g) + 0.0722*lin(b)
+}
+
+// Contrast returns the WCAG 2.x contrast ratio between a and b, a value
+// from 1 (no contrast) to 21 (black against white).
+func Contrast(a, b Color) float64 {
+	la := RelativeLuminance(a)
+	lb := RelativeLuminance(b)
+	if la < lb {
+		la, lb = lb, la
+	}
+	return (la + 0.05) / (lb + 0.05)
+}
+
+// PassesAA reports whether fg against bg meets the WCAG AA contrast
+// requirement: 4.5:1, or 3:1 for large text.
+func PassesAA(fg, bg Color, largeText bool) bool {
+	threshold := aaNormalText
+	if largeText {
+		threshold = aaLargeText
+	}
+	return Contrast(fg, bg) >= threshold
+}
+
+// PassesAAA reports whether fg against bg meets the WCAG AAA contrast
+// requirement: 7:1, or 4.5:1 for large text.
+func PassesAAA(fg, bg Color, largeText bool) bool {
+	threshold := aaaNormalText
+	if largeText {
+		threshold = aaaLargeText
+	}
+	return Contrast(fg, bg) >= threshold
+}
+
+// SuggestAccessible returns a Color as close as possible to fg, preserving
+// its Hue, that reaches the given contrast ratio target against bg. It
+// tries every well-formed (Blackness, Chromaticness) pair and keeps the
+// one with the smallest ΔBlackness+ΔChromaticness that meets the target;
+// if no pair reaches it, the closest available contrast is returned
+// instead.
+func SuggestAccessible(fg, bg Color, target float64) Color {
+	var (
+		bestMeeting     Color
+		bestMeetingOK   bool
+		bestMeetingDist int
+		bestFallback    Color
+		bestFallbackCR  float64
+	)
+	for bl := 0; bl <= 99; bl++ {
+		maxC := 100 - bl
+		if maxC > 99 {
+			maxC = 99
+		}
+		for ch := 0; ch <= maxC; ch++ {
+			h := fg.Hue
+			if ch == 0 {
+				h = 0
+			}
+			c := Color{Blackness: bl, Chromaticness: ch, Hue: h}
+			cr := Contrast(c, bg)
+
+			if cr > bestFallbackCR {
+				bestFallbackCR = cr
+				bestFallback = c
+			}
+
+			if cr < target {
+				continue
+			}
+			d := abs(bl-fg.Blackness) + abs(ch-fg.Chromaticness)
+			if !bestMeetingOK || d < bestMeetingDist {
+				bestMeeting = c
+				bestMeetingDist = d
+				bestMeetingOK = true
+			}
+		}
+	}
+	if bestMeetingOK {
+		return bestMeeting
+	}
+	return bestFallback
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,66 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncs_test
+
+import (
+	"math"
+	"testing"
+
+	. "github.com/hajimehoshi/ncs"
+)
+
+func TestContrast(t *testing.T) {
+	black := Color{Blackness: 99, Chromaticness: 0, Hue: 0}
+	white := Color{Blackness: 0, Chromaticness: 0, Hue: 0}
+
+	got := Contrast(black, white)
+	if math.Abs(got-21) > 0.5 {
+		t.Errorf("Contrast(black, white): got %v, want close to 21", got)
+	}
+
+	if got := Contrast(white, white); math.Abs(got-1) > 1e-9 {
+		t.Errorf("Contrast(white, white): got %v, want 1", got)
+	}
+}
+
+func TestPassesAAAndAAA(t *testing.T) {
+	black := Color{Blackness: 99, Chromaticness: 0, Hue: 0}
+	white := Color{Blackness: 0, Chromaticness: 0, Hue: 0}
+
+	if !PassesAA(black, white, false) {
+		t.Errorf("PassesAA(black, white, false): got false, want true")
+	}
+	if !PassesAAA(black, white, false) {
+		t.Errorf("PassesAAA(black, white, false): got false, want true")
+	}
+
+	gray := Color{Blackness: 50, Chromaticness: 0, Hue: 0}
+	if PassesAAA(gray, white, false) {
+		t.Errorf("PassesAAA(gray, white, false): got true, want false")
+	}
+}
+
+func TestSuggestAccessible(t *testing.T) {
+	white := Color{Blackness: 0, Chromaticness: 0, Hue: 0}
+	fg := Color{Blackness: 20, Chromaticness: 10, Hue: 10}
+
+	got := SuggestAccessible(fg, white, 4.5)
+	if got.Chromaticness > 0 && got.Hue != fg.Hue {
+		t.Errorf("SuggestAccessible: got Hue %d with Chromaticness %d, want preserved Hue %d", got.Hue, got.Chromaticness, fg.Hue)
+	}
+	if !PassesAA(got, white, false) {
+		t.Errorf("SuggestAccessible(%v, %v, 4.5) = %v: does not pass AA (contrast %v)", fg, white, got, Contrast(got, white))
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package css
+
+import "math"
+
+// clamp01 clamps v to [0, 1].
+func clamp01(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
+
+// srgbEncode applies the sRGB transfer function to a linear-light value
+// in [0, 1].
+func srgbEncode(c float64) float64 {
+	if c <= 0.0031308 {
+		return 12.92 * c
+	}
+	return 1.055*math.Pow(c, 1/2.4) - 0.055
+}
+
+// srgbDecode removes the sRGB transfer function from a gamma-encoded
+// value in [0, 1], returning linear light.
+func srgbDecode(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+// linearToSRGB255 converts a linear-light RGB triple in [0, 1] to
+// gamma-encoded 0-255 sRGB, clamping out-of-gamut components.
+//
+// Clamping per channel, rather than e.g. scaling the whole triple down,
+// is the documented out-of-gamut strategy this package uses: it is the
+// same naive clip browsers fall back to and keeps hue and lightness
+// close to the request at the cost of some saturation.
+func linearToSRGB255(r, g, b float64) (r255, g255, b255 float64) {
+	return 255 * clamp01(srgbEncode(r)), 255 * clamp01(srgbEncode(g)), 255 * clamp01(srgbEncode(b))
+}
+
+// XYZ of the D65 white point, used by the Lab conversion below.
+const (
+	d65X = 0.95047
+	d65Y = 1.00000
+	d65Z = 1.08883
+)
+
+// xyzToLinearSRGB converts CIE 1931 XYZ (D65) to linear-light sRGB.
+func xyzToLinearSRGB(x, y, z float64) (r, g, b float64) {
+	r = 3.2404542*x - 1.5371385*y - 0.4985314*z
+	g = -0.9692660*x + 1.8760108*y + 0.0415560*z
+	b = 0.0556434*x - 0.2040259*y + 1.0572252*z
+	return
+}
+
+// labToXYZ converts CIE L*a*b* (D65) to CIE 1931 XYZ.
+func labToXYZ(l, a, b float64) (x, y, z float64) {
+	const delta = 6.0 / 29.0
+	finv := func(t float64) float64 {
+		if t > delta {
+			return t * t * t
+		}
+		return 3 * delta * delta * (t - 4.0/29.0)
+	}
+	fy := (l + 16) / 116
+	fx := fy + a/500
+	fz := fy - b/200
+	return finv(fx) * d65X, finv(fy) * d65Y, finv(fz) * d65Z
+}
+
+// p3LinearToXYZ converts linear-light Display P3 to CIE 1931 XYZ (D65).
+func p3LinearToXYZ(r, g, b float64) (x, y, z float64) {
+	x = 0.4865709486482162*r + 0.26566769316909306*g + 0.19821728523436247*b
+	y = 0.2289745640697488*r + 0.6917385218365064*g + 0.0792869140937450*b
+	z = 0.0000000000000000*r + 0.04511338185890264*g + 1.0439443689009760*b
+	return
+}
+
+// oklabToLinearSRGB converts OKLab to linear-light sRGB.
+// See https://bottosson.github.io/posts/oklab/.
+func oklabToLinearSRGB(l, a, b float64) (r, g, bl float64) {
+	l_ := l + 0.3963377774*a + 0.2158037573*b
+	m_ := l - 0.1055613458*a - 0.0638541728*b
+	s_ := l - 0.0894841775*a - 1.2914855480*b
+
+	ll := l_ * l_ * l_
+	mm := m_ * m_ * m_
+	ss := s_ * s_ * s_
+
+	r = 4.0767416621*ll - 3.3077115913*mm + 0.2309699292*ss
+	g = -1.2684380046*ll + 2.6097574011*mm - 0.3413193965*ss
+	bl = -0.0041960863*ll - 0.7034186147*mm + 1.7076147010*ss
+	return r, g, bl
+}
@@ -0,0 +1,347 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package css parses CSS Color Module Level 4 color syntax into
+// image/color.Color, and bridges it to Natural Color System notation via
+// the ncs package's reverse conversion.
+package css
+
+import (
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/ncs"
+)
+
+// Parse parses a CSS color value and returns the corresponding color.
+//
+// The supported syntaxes are #rgb, #rgba, #rrggbb and #rrggbbaa hex
+// colors; legacy comma and modern space-separated rgb(), rgba(), hsl()
+// and hsla(); hwb(); lab(); lch(); oklab(); oklch(); color(display-p3 ...);
+// and the CSS named color keywords. Percentages, the none keyword and
+// alpha specified with a trailing "/ alpha" are all accepted wherever CSS
+// allows them.
+//
+// Components outside a color space's gamut are clamped, not rejected; see
+// linearToSRGB255 for the clamping strategy.
+func Parse(s string) (color.Color, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("css: invalid color: %q", s)
+	}
+
+	if strings.HasPrefix(s, "#") {
+		return parseHex(s)
+	}
+
+	if c, ok := named[strings.ToLower(s)]; ok {
+		return c, nil
+	}
+
+	open := strings.IndexByte(s, '(')
+	if open < 0 || !strings.HasSuffix(s, ")") {
+		return nil, fmt.Errorf("css: invalid color: %q", s)
+	}
+	fn := strings.ToLower(strings.TrimSpace(s[:open]))
+	inner := s[open+1 : len(s)-1]
+
+	switch fn {
+	case "rgb", "rgba":
+		return parseRGB(inner)
+	case "hsl", "hsla":
+		return parseHSL(inner)
+	case "hwb":
+		return parseHWB(inner)
+	case "lab":
+		return parseLab(inner)
+	case "lch":
+		return parseLCH(inner)
+	case "oklab":
+		return parseOKLab(inner)
+	case "oklch":
+		return parseOKLCH(inner)
+	case "color":
+		return parseColorFn(inner)
+	default:
+		return nil, fmt.Errorf("css: unknown color function: %q", fn)
+	}
+}
+
+// ParseNCS parses a CSS color value, as Parse does, and converts the
+// result to its closest NCS notation via ncs.FromColor.
+func ParseNCS(s string) (ncs.Color, error) {
+	c, err := Parse(s)
+	if err != nil {
+		return ncs.Color{}, err
+	}
+	return ncs.FromColor(c), nil
+}
+
+// straight builds a color.Color from straight (non-premultiplied) 0-255
+// channels and an alpha in [0, 1].
+func straight(r, g, b, a float64) color.Color {
+	return color.NRGBA64{
+		R: uint16(clamp01(r/255) * 0xffff),
+		G: uint16(clamp01(g/255) * 0xffff),
+		B: uint16(clamp01(b/255) * 0xffff),
+		A: uint16(clamp01(a) * 0xffff),
+	}
+}
+
+func parseHex(s string) (color.Color, error) {
+	h := s[1:]
+	expand := func(c byte) byte {
+		n, _ := strconv.ParseUint(string(c)+string(c), 16, 8)
+		return byte(n)
+	}
+	hx := func(a, b byte) float64 {
+		n, err := strconv.ParseUint(string(a)+string(b), 16, 8)
+		if err != nil {
+			return 0
+		}
+		return float64(n)
+	}
+	switch len(h) {
+	case 3, 4:
+		r := expand(h[0])
+		g := expand(h[1])
+		b := expand(h[2])
+		a := byte(0xff)
+		if len(h) == 4 {
+			a = expand(h[3])
+		}
+		return straight(float64(r), float64(g), float64(b), float64(a)/0xff), nil
+	case 6, 8:
+		a := 255.0
+		if len(h) == 8 {
+			a = hx(h[6], h[7])
+		}
+		return straight(hx(h[0], h[1]), hx(h[2], h[3]), hx(h[4], h[5]), a/255), nil
+	default:
+		return nil, fmt.Errorf("css: invalid hex color: %q", s)
+	}
+}
+
+// splitComponents splits the inside of a color function call into its
+// components and an optional alpha string, handling both the legacy
+// comma syntax (where a trailing 4th component is alpha) and the modern
+// space syntax with an alpha introduced by "/".
+func splitComponents(s string) (comps []string, alpha string) {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '/'); i >= 0 {
+		alpha = strings.TrimSpace(s[i+1:])
+		s = strings.TrimSpace(s[:i])
+	}
+	if strings.Contains(s, ",") {
+		for _, p := range strings.Split(s, ",") {
+			comps = append(comps, strings.TrimSpace(p))
+		}
+		if alpha == "" && len(comps) == 4 {
+			alpha = comps[3]
+			comps = comps[:3]
+		}
+		return comps, alpha
+	}
+	return strings.Fields(s), alpha
+}
+
+// parseAlpha parses a CSS alpha component (0-1, a percentage, or none)
+// and returns a value in [0, 1].
+func parseAlpha(s string) (float64, error) {
+	if s == "" || s == "none" {
+		if s == "" {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	if strings.HasSuffix(s, "%") {
+		v, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if err != nil {
+			return 0, err
+		}
+		return clamp01(v / 100), nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	return clamp01(v), nil
+}
+
+// parseNumberOrPercent parses a component that may be a bare number, a
+// percentage of max, or none (treated as 0).
+func parseNumberOrPercent(s string, max float64) (float64, error) {
+	if s == "none" {
+		return 0, nil
+	}
+	if strings.HasSuffix(s, "%") {
+		v, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+		if err != nil {
+			return 0, err
+		}
+		return v / 100 * max, nil
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// parseHue parses a <hue> component: a bare number or one with an
+// explicit "deg" unit.
+func parseHue(s string) (float64, error) {
+	if s == "none" {
+		return 0, nil
+	}
+	s = strings.TrimSuffix(s, "deg")
+	return strconv.ParseFloat(s, 64)
+}
+
+func parseRGB(inner string) (color.Color, error) {
+	comps, alphaStr, err := requireComponents(inner, 3)
+	if err != nil {
+		return nil, err
+	}
+	r, err := parseNumberOrPercent(comps[0], 255)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid rgb() red component: %q", comps[0])
+	}
+	g, err := parseNumberOrPercent(comps[1], 255)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid rgb() green component: %q", comps[1])
+	}
+	b, err := parseNumberOrPercent(comps[2], 255)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid rgb() blue component: %q", comps[2])
+	}
+	a, err := parseAlpha(alphaStr)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid alpha: %q", alphaStr)
+	}
+	return straight(r, g, b, a), nil
+}
+
+func parseHSL(inner string) (color.Color, error) {
+	comps, alphaStr, err := requireComponents(inner, 3)
+	if err != nil {
+		return nil, err
+	}
+	h, err := parseHue(comps[0])
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid hsl() hue component: %q", comps[0])
+	}
+	s, err := parseNumberOrPercent(comps[1], 1)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid hsl() saturation component: %q", comps[1])
+	}
+	l, err := parseNumberOrPercent(comps[2], 1)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid hsl() lightness component: %q", comps[2])
+	}
+	a, err := parseAlpha(alphaStr)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid alpha: %q", alphaStr)
+	}
+	r, g, b := hslToRGB255(h, clamp01(s), clamp01(l))
+	return straight(r, g, b, a), nil
+}
+
+func parseHWB(inner string) (color.Color, error) {
+	comps, alphaStr, err := requireComponents(inner, 3)
+	if err != nil {
+		return nil, err
+	}
+	h, err := parseHue(comps[0])
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid hwb() hue component: %q", comps[0])
+	}
+	w, err := parseNumberOrPercent(comps[1], 1)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid hwb() whiteness component: %q", comps[1])
+	}
+	bl, err := parseNumberOrPercent(comps[2], 1)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid hwb() blackness component: %q", comps[2])
+	}
+	a, err := parseAlpha(alphaStr)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid alpha: %q", alphaStr)
+	}
+	r, g, b := hwbToRGB255(h, clamp01(w), clamp01(bl))
+	return straight(r, g, b, a), nil
+}
+
+// requireComponents splits inner into exactly n color components plus an
+// optional alpha string.
+func requireComponents(inner string, n int) ([]string, string, error) {
+	comps, alpha := splitComponents(inner)
+	if len(comps) != n {
+		return nil, "", fmt.Errorf("css: expected %d components, got %q", n, inner)
+	}
+	return comps, alpha, nil
+}
+
+// hslToRGB255 converts HSL (hue in degrees, saturation and lightness in
+// [0, 1]) to sRGB in [0, 255], per the CSS Color algorithm.
+func hslToRGB255(h, s, l float64) (r, g, b float64) {
+	h = mod(h, 360)
+	c := (1 - abs(2*l-1)) * s
+	x := c * (1 - abs(mod(h/60, 2)-1))
+	m := l - c/2
+	var r1, g1, b1 float64
+	switch {
+	case h < 60:
+		r1, g1, b1 = c, x, 0
+	case h < 120:
+		r1, g1, b1 = x, c, 0
+	case h < 180:
+		r1, g1, b1 = 0, c, x
+	case h < 240:
+		r1, g1, b1 = 0, x, c
+	case h < 300:
+		r1, g1, b1 = x, 0, c
+	default:
+		r1, g1, b1 = c, 0, x
+	}
+	return (r1 + m) * 255, (g1 + m) * 255, (b1 + m) * 255
+}
+
+// hwbToRGB255 converts HWB (hue in degrees, whiteness and blackness in
+// [0, 1]) to sRGB in [0, 255].
+func hwbToRGB255(h, w, b float64) (r, g, bl float64) {
+	if w+b >= 1 {
+		gray := w / (w + b) * 255
+		return gray, gray, gray
+	}
+	r, g, bl = hslToRGB255(h, 1, 0.5)
+	factor := 1 - w - b
+	r = r*factor + w*255
+	g = g*factor + w*255
+	bl = bl*factor + w*255
+	return
+}
+
+func mod(a, b float64) float64 {
+	m := a - b*float64(int(a/b))
+	if m < 0 {
+		m += b
+	}
+	return m
+}
+
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
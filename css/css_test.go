@@ -0,0 +1,126 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package css_test
+
+import (
+	"testing"
+
+	. "github.com/hajimehoshi/ncs/css"
+)
+
+// rgba returns c's straight (non-alpha-premultiplied) 8-bit channels.
+// color.Color.RGBA always returns alpha-premultiplied values, so this
+// undoes that to get back the components Parse was given.
+func rgba(c interface {
+	RGBA() (r, g, b, a uint32)
+}) (r, g, b, a uint32) {
+	r, g, b, a = c.RGBA()
+	if a == 0 {
+		return 0, 0, 0, 0
+	}
+	r = r * 0xffff / a
+	g = g * 0xffff / a
+	b = b * 0xffff / a
+	return r >> 8, g >> 8, b >> 8, a >> 8
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		In         string
+		R, G, B, A uint32
+	}{
+		{In: "#f00", R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+		{In: "#ff0000", R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+		{In: "#ff000080", R: 0xff, G: 0x00, B: 0x00, A: 0x80},
+		{In: "rgb(255, 0, 0)", R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+		{In: "rgb(255 0 0)", R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+		{In: "rgba(255, 0, 0, 0.5)", R: 0xff, G: 0x00, B: 0x00, A: 0x7f},
+		{In: "rgb(255 0 0 / 50%)", R: 0xff, G: 0x00, B: 0x00, A: 0x7f},
+		{In: "rgb(100% 0% 0%)", R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+		{In: "hsl(0, 100%, 50%)", R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+		{In: "hwb(0 0% 0%)", R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+		{In: "red", R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+		{In: "RED", R: 0xff, G: 0x00, B: 0x00, A: 0xff},
+		{In: "rebeccapurple", R: 0x66, G: 0x33, B: 0x99, A: 0xff},
+		{In: "transparent", R: 0x00, G: 0x00, B: 0x00, A: 0x00},
+	}
+
+	for _, c := range cases {
+		got, err := Parse(c.In)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", c.In, err)
+		}
+		r, g, b, a := rgba(got)
+		if r != c.R || g != c.G || b != c.B || a != c.A {
+			t.Errorf("Parse(%q): got (%d, %d, %d, %d), want (%d, %d, %d, %d)", c.In, r, g, b, a, c.R, c.G, c.B, c.A)
+		}
+	}
+}
+
+func TestParseRoundTripsApproximately(t *testing.T) {
+	// lab(), lch(), oklab(), oklch() and color(display-p3 ...) each encode
+	// a mid gray; each should parse to a gray near 0x80.
+	cases := []string{
+		"lab(53.39 0 0)",
+		"lch(53.39 0 0)",
+		"oklab(0.566 0 0)",
+		"oklch(0.566 0 0)",
+		"color(display-p3 0.5 0.5 0.5)",
+	}
+	for _, in := range cases {
+		got, err := Parse(in)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", in, err)
+		}
+		r, g, b, _ := rgba(got)
+		near := func(x, y uint32) bool {
+			if x > y {
+				x, y = y, x
+			}
+			return y-x <= 1
+		}
+		if !near(r, g) || !near(g, b) {
+			t.Errorf("Parse(%q): got non-gray (%d, %d, %d)", in, r, g, b)
+		}
+		if r < 0x70 || r > 0x90 {
+			t.Errorf("Parse(%q): got gray level %d, want roughly 0x80", in, r)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	cases := []string{
+		"",
+		"#ff",
+		"notacolor",
+		"rgb(1, 2)",
+		"color(foo-bar 1 1 1)",
+	}
+	for _, in := range cases {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q): got nil error, want non-nil", in)
+		}
+	}
+}
+
+func TestParseNCS(t *testing.T) {
+	c, err := ParseNCS("red")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Chromaticness == 0 {
+		t.Errorf("ParseNCS(%q): got achromatic %v, want chromatic", "red", c)
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package css
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strings"
+)
+
+func parseLab(inner string) (color.Color, error) {
+	comps, alphaStr, err := requireComponents(inner, 3)
+	if err != nil {
+		return nil, err
+	}
+	l, err := parseNumberOrPercent(comps[0], 100)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid lab() L component: %q", comps[0])
+	}
+	a, err := parseNumberOrPercent(comps[1], 125)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid lab() a component: %q", comps[1])
+	}
+	b, err := parseNumberOrPercent(comps[2], 125)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid lab() b component: %q", comps[2])
+	}
+	alpha, err := parseAlpha(alphaStr)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid alpha: %q", alphaStr)
+	}
+	x, y, z := labToXYZ(l, a, b)
+	r, g, bl := xyzToLinearSRGB(x, y, z)
+	r255, g255, b255 := linearToSRGB255(r, g, bl)
+	return straight(r255, g255, b255, alpha), nil
+}
+
+func parseLCH(inner string) (color.Color, error) {
+	comps, alphaStr, err := requireComponents(inner, 3)
+	if err != nil {
+		return nil, err
+	}
+	l, err := parseNumberOrPercent(comps[0], 100)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid lch() L component: %q", comps[0])
+	}
+	c, err := parseNumberOrPercent(comps[1], 150)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid lch() C component: %q", comps[1])
+	}
+	h, err := parseHue(comps[2])
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid lch() H component: %q", comps[2])
+	}
+	alpha, err := parseAlpha(alphaStr)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid alpha: %q", alphaStr)
+	}
+	hr := h * math.Pi / 180
+	a := c * math.Cos(hr)
+	bb := c * math.Sin(hr)
+	x, y, z := labToXYZ(l, a, bb)
+	r, g, bl := xyzToLinearSRGB(x, y, z)
+	r255, g255, b255 := linearToSRGB255(r, g, bl)
+	return straight(r255, g255, b255, alpha), nil
+}
+
+func parseOKLab(inner string) (color.Color, error) {
+	comps, alphaStr, err := requireComponents(inner, 3)
+	if err != nil {
+		return nil, err
+	}
+	l, err := parseNumberOrPercent(comps[0], 1)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid oklab() L component: %q", comps[0])
+	}
+	a, err := parseNumberOrPercent(comps[1], 0.4)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid oklab() a component: %q", comps[1])
+	}
+	b, err := parseNumberOrPercent(comps[2], 0.4)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid oklab() b component: %q", comps[2])
+	}
+	alpha, err := parseAlpha(alphaStr)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid alpha: %q", alphaStr)
+	}
+	r, g, bl := oklabToLinearSRGB(l, a, b)
+	r255, g255, b255 := linearToSRGB255(r, g, bl)
+	return straight(r255, g255, b255, alpha), nil
+}
+
+func parseOKLCH(inner string) (color.Color, error) {
+	comps, alphaStr, err := requireComponents(inner, 3)
+	if err != nil {
+		return nil, err
+	}
+	l, err := parseNumberOrPercent(comps[0], 1)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid oklch() L component: %q", comps[0])
+	}
+	c, err := parseNumberOrPercent(comps[1], 0.4)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid oklch() C component: %q", comps[1])
+	}
+	h, err := parseHue(comps[2])
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid oklch() H component: %q", comps[2])
+	}
+	alpha, err := parseAlpha(alphaStr)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid alpha: %q", alphaStr)
+	}
+	hr := h * math.Pi / 180
+	a := c * math.Cos(hr)
+	b := c * math.Sin(hr)
+	r, g, bl := oklabToLinearSRGB(l, a, b)
+	r255, g255, b255 := linearToSRGB255(r, g, bl)
+	return straight(r255, g255, b255, alpha), nil
+}
+
+// parseColorFn parses the inside of a color(...) function. Only
+// display-p3 is currently supported, since it is by far the most common
+// predefined RGB color space seen in the wild beyond sRGB.
+func parseColorFn(inner string) (color.Color, error) {
+	fields := strings.Fields(strings.TrimSpace(inner))
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("css: invalid color(): %q", inner)
+	}
+	space := strings.ToLower(fields[0])
+	if space != "display-p3" {
+		return nil, fmt.Errorf("css: unsupported color() space: %q", space)
+	}
+	rest := strings.Join(fields[1:], " ")
+	comps, alphaStr := splitComponents(rest)
+	if len(comps) != 3 {
+		return nil, fmt.Errorf("css: invalid color(display-p3 ...): %q", inner)
+	}
+	r, err := parseNumberOrPercent(comps[0], 1)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid color() red component: %q", comps[0])
+	}
+	g, err := parseNumberOrPercent(comps[1], 1)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid color() green component: %q", comps[1])
+	}
+	b, err := parseNumberOrPercent(comps[2], 1)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid color() blue component: %q", comps[2])
+	}
+	alpha, err := parseAlpha(alphaStr)
+	if err != nil {
+		return nil, fmt.Errorf("css: invalid alpha: %q", alphaStr)
+	}
+	rl, gl, bl := srgbDecode(clamp01(r)), srgbDecode(clamp01(g)), srgbDecode(clamp01(b))
+	x, y, z := p3LinearToXYZ(rl, gl, bl)
+	rs, gs, bs := xyzToLinearSRGB(x, y, z)
+	r255, g255, b255 := linearToSRGB255(rs, gs, bs)
+	return straight(r255, g255, b255, alpha), nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package css
+
+import "image/color"
+
+// named maps the CSS Color Module Level 4 extended color keywords,
+// lowercased, to their sRGB values. "transparent" is included; "currentcolor"
+// is not, since resolving it requires element context this package does
+// not have.
+var named = map[string]color.Color{
+	"aliceblue":            color.NRGBA{0xf0, 0xf8, 0xff, 0xff},
+	"antiquewhite":         color.NRGBA{0xfa, 0xeb, 0xd7, 0xff},
+	"aqua":                 color.NRGBA{0x00, 0xff, 0xff, 0xff},
+	"aquamarine":           color.NRGBA{0x7f, 0xff, 0xd4, 0xff},
+	"azure":                color.NRGBA{0xf0, 0xff, 0xff, 0xff},
+	"beige":                color.NRGBA{0xf5, 0xf5, 0xdc, 0xff},
+	"bisque":               color.NRGBA{0xff, 0xe4, 0xc4, 0xff},
+	"black":                color.NRGBA{0x00, 0x00, 0x00, 0xff},
+	"blanchedalmond":       color.NRGBA{0xff, 0xeb, 0xcd, 0xff},
+	"blue":                 color.NRGBA{0x00, 0x00, 0xff, 0xff},
+	"blueviolet":           color.NRGBA{0x8a, 0x2b, 0xe2, 0xff},
+	"brown":                color.NRGBA{0xa5, 0x2a, 0x2a, 0xff},
+	"burlywood":            color.NRGBA{0xde, 0xb8, 0x87, 0xff},
+	"cadetblue":            color.NRGBA{0x5f, 0x9e, 0xa0, 0xff},
+	"chartreuse":           color.NRGBA{0x7f, 0xff, 0x00, 0xff},
+	"chocolate":            color.NRGBA{0xd2, 0x69, 0x1e, 0xff},
+	"coral":                color.NRGBA{0xff, 0x7f, 0x50, 0xff},
+	"cornflowerblue":       color.NRGBA{0x64, 0x95, 0xed, 0xff},
+	"cornsilk":             color.NRGBA{0xff, 0xf8, 0xdc, 0xff},
+	"crimson":              color.NRGBA{0xdc, 0x14, 0x3c, 0xff},
+	"cyan":                 color.NRGBA{0x00, 0xff, 0xff, 0xff},
+	"darkblue":             color.NRGBA{0x00, 0x00, 0x8b, 0xff},
+	"darkcyan":             color.NRGBA{0x00, 0x8b, 0x8b, 0xff},
+	"darkgoldenrod":        color.NRGBA{0xb8, 0x86, 0x0b, 0xff},
+	"darkgray":             color.NRGBA{0xa9, 0xa9, 0xa9, 0xff},
+	"darkgreen":            color.NRGBA{0x00, 0x64, 0x00, 0xff},
+	"darkgrey":             color.NRGBA{0xa9, 0xa9, 0xa9, 0xff},
+	"darkkhaki":            color.NRGBA{0xbd, 0xb7, 0x6b, 0xff},
+	"darkmagenta":          color.NRGBA{0x8b, 0x00, 0x8b, 0xff},
+	"darkolivegreen":       color.NRGBA{0x55, 0x6b, 0x2f, 0xff},
+	"darkorange":           color.NRGBA{0xff, 0x8c, 0x00, 0xff},
+	"darkorchid":           color.NRGBA{0x99, 0x32, 0xcc, 0xff},
+	"darkred":              color.NRGBA{0x8b, 0x00, 0x00, 0xff},
+	"darksalmon":           color.NRGBA{0xe9, 0x96, 0x7a, 0xff},
+	"darkseagreen":         color.NRGBA{0x8f, 0xbc, 0x8f, 0xff},
+	"darkslateblue":        color.NRGBA{0x48, 0x3d, 0x8b, 0xff},
+	"darkslategray":        color.NRGBA{0x2f, 0x4f, 0x4f, 0xff},
+	"darkslategrey":        color.NRGBA{0x2f, 0x4f, 0x4f, 0xff},
+	"darkturquoise":        color.NRGBA{0x00, 0xce, 0xd1, 0xff},
+	"darkviolet":           color.NRGBA{0x94, 0x00, 0xd3, 0xff},
+	"deeppink":             color.NRGBA{0xff, 0x14, 0x93, 0xff},
+	"deepskyblue":          color.NRGBA{0x00, 0xbf, 0xff, 0xff},
+	"dimgray":              color.NRGBA{0x69, 0x69, 0x69, 0xff},
+	"dimgrey":              color.NRGBA{0x69, 0x69, 0x69, 0xff},
+	"dodgerblue":           color.NRGBA{0x1e, 0x90, 0xff, 0xff},
+	"firebrick":            color.NRGBA{0xb2, 0x22, 0x22, 0xff},
+	"floralwhite":          color.NRGBA{0xff, 0xfa, 0xf0, 0xff},
+	"forestgreen":          color.NRGBA{0x22, 0x8b, 0x22, 0xff},
+	"fuchsia":              color.NRGBA{0xff, 0x00, 0xff, 0xff},
+	"gainsboro":            color.NRGBA{0xdc, 0xdc, 0xdc, 0xff},
+	"ghostwhite":           color.NRGBA{0xf8, 0xf8, 0xff, 0xff},
+	"gold":                 color.NRGBA{0xff, 0xd7, 0x00, 0xff},
+	"goldenrod":            color.NRGBA{0xda, 0xa5, 0x20, 0xff},
+	"gray":                 color.NRGBA{0x80, 0x80, 0x80, 0xff},
+	"green":                color.NRGBA{0x00, 0x80, 0x00, 0xff},
+	"greenyellow":          color.NRGBA{0xad, 0xff, 0x2f, 0xff},
+	"grey":                 color.NRGBA{0x80, 0x80, 0x80, 0xff},
+	"honeydew":             color.NRGBA{0xf0, 0xff, 0xf0, 0xff},
+	"hotpink":              color.NRGBA{0xff, 0x69, 0xb4, 0xff},
+	"indianred":            color.NRGBA{0xcd, 0x5c, 0x5c, 0xff},
+	"indigo":               color.NRGBA{0x4b, 0x00, 0x82, 0xff},
+	"ivory":                color.NRGBA{0xff, 0xff, 0xf0, 0xff},
+	"khaki":                color.NRGBA{0xf0, 0xe6, 0x8c, 0xff},
+	"lavender":             color.NRGBA{0xe6, 0xe6, 0xfa, 0xff},
+	"lavenderblush":        color.NRGBA{0xff, 0xf0, 0xf5, 0xff},
+	"lawngreen":            color.NRGBA{0x7c, 0xfc, 0x00, 0xff},
+	"lemonchiffon":         color.NRGBA{0xff, 0xfa, 0xcd, 0xff},
+	"lightblue":            color.NRGBA{0xad, 0xd8, 0xe6, 0xff},
+	"lightcoral":           color.NRGBA{0xf0, 0x80, 0x80, 0xff},
+	"lightcyan":            color.NRGBA{0xe0, 0xff, 0xff, 0xff},
+	"lightgoldenrodyellow": color.NRGBA{0xfa, 0xfa, 0xd2, 0xff},
+	"lightgray":            color.NRGBA{0xd3, 0xd3, 0xd3, 0xff},
+	"lightgreen":           color.NRGBA{0x90, 0xee, 0x90, 0xff},
+	"lightgrey":            color.NRGBA{0xd3, 0xd3, 0xd3, 0xff},
+	"lightpink":            color.NRGBA{0xff, 0xb6, 0xc1, 0xff},
+	"lightsalmon":          color.NRGBA{0xff, 0xa0, 0x7a, 0xff},
+	"lightseagreen":        color.NRGBA{0x20, 0xb2, 0xaa, 0xff},
+	"lightskyblue":         color.NRGBA{0x87, 0xce, 0xfa, 0xff},
+	"lightslategray":       color.NRGBA{0x77, 0x88, 0x99, 0xff},
+	"lightslategrey":       color.NRGBA{0x77, 0x88, 0x99, 0xff},
+	"lightsteelblue":       color.NRGBA{0xb0, 0xc4, 0xde, 0xff},
+	"lightyellow":          color.NRGBA{0xff, 0xff, 0xe0, 0xff},
+	"lime":                 color.NRGBA{0x00, 0xff, 0x00, 0xff},
+	"limegreen":            color.NRGBA{0x32, 0xcd, 0x32, 0xff},
+	"linen":                color.NRGBA{0xfa, 0xf0, 0xe6, 0xff},
+	"magenta":              color.NRGBA{0xff, 0x00, 0xff, 0xff},
+	"maroon":               color.NRGBA{0x80, 0x00, 0x00, 0xff},
+	"mediumaquamarine":     color.NRGBA{0x66, 0xcd, 0xaa, 0xff},
+	"mediumblue":           color.NRGBA{0x00, 0x00, 0xcd, 0xff},
+	"mediumorchid":         color.NRGBA{0xba, 0x55, 0xd3, 0xff},
+	"mediumpurple":         color.NRGBA{0x93, 0x70, 0xdb, 0xff},
+	"mediumseagreen":       color.NRGBA{0x3c, 0xb3, 0x71, 0xff},
+	"mediumslateblue":      color.NRGBA{0x7b, 0x68, 0xee, 0xff},
+	"mediumspringgreen":    color.NRGBA{0x00, 0xfa, 0x9a, 0xff},
+	"mediumturquoise":      color.NRGBA{0x48, 0xd1, 0xcc, 0xff},
+	"mediumvioletred":      color.NRGBA{0xc7, 0x15, 0x85, 0xff},
+	"midnightblue":         color.NRGBA{0x19, 0x19, 0x70, 0xff},
+	"mintcream":            color.NRGBA{0xf5, 0xff, 0xfa, 0xff},
+	"mistyrose":            color.NRGBA{0xff, 0xe4, 0xe1, 0xff},
+	"moccasin":             color.NRGBA{0xff, 0xe4, 0xb5, 0xff},
+	"navajowhite":          color.NRGBA{0xff, 0xde, 0xad, 0xff},
+	"navy":                 color.NRGBA{0x00, 0x00, 0x80, 0xff},
+	"oldlace":              color.NRGBA{0xfd, 0xf5, 0xe6, 0xff},
+	"olive":                color.NRGBA{0x80, 0x80, 0x00, 0xff},
+	"olivedrab":            color.NRGBA{0x6b, 0x8e, 0x23, 0xff},
+	"orange":               color.NRGBA{0xff, 0xa5, 0x00, 0xff},
+	"orangered":            color.NRGBA{0xff, 0x45, 0x00, 0xff},
+	"orchid":               color.NRGBA{0xda, 0x70, 0xd6, 0xff},
+	"palegoldenrod":        color.NRGBA{0xee, 0xe8, 0xaa, 0xff},
+	"palegreen":            color.NRGBA{0x98, 0xfb, 0x98, 0xff},
+	"paleturquoise":        color.NRGBA{0xaf, 0xee, 0xee, 0xff},
+	"palevioletred":        color.NRGBA{0xdb, 0x70, 0x93, 0xff},
+	"papayawhip":           color.NRGBA{0xff, 0xef, 0xd5, 0xff},
+	"peachpuff":            color.NRGBA{0xff, 0xda, 0xb9, 0xff},
+	"peru":                 color.NRGBA{0xcd, 0x85, 0x3f, 0xff},
+	"pink":                 color.NRGBA{0xff, 0xc0, 0xcb, 0xff},
+	"plum":                 color.NRGBA{0xdd, 0xa0, 0xdd, 0xff},
+	"powderblue":           color.NRGBA{0xb0, 0xe0, 0xe6, 0xff},
+	"purple":               color.NRGBA{0x80, 0x00, 0x80, 0xff},
+	"rebeccapurple":        color.NRGBA{0x66, 0x33, 0x99, 0xff},
+	"red":                  color.NRGBA{0xff, 0x00, 0x00, 0xff},
+	"rosybrown":            color.NRGBA{0xbc, 0x8f, 0x8f, 0xff},
+	"royalblue":            color.NRGBA{0x41, 0x69, 0xe1, 0xff},
+	"saddlebrown":          color.NRGBA{0x8b, 0x45, 0x13, 0xff},
+	"salmon":               color.NRGBA{0xfa, 0x80, 0x72, 0xff},
+	"sandybrown":           color.NRGBA{0xf4, 0xa4, 0x60, 0xff},
+	"seagreen":             color.NRGBA{0x2e, 0x8b, 0x57, 0xff},
+	"seashell":             color.NRGBA{0xff, 0xf5, 0xee, 0xff},
+	"sienna":               color.NRGBA{0xa0, 0x52, 0x2d, 0xff},
+	"silver":               color.NRGBA{0xc0, 0xc0, 0xc0, 0xff},
+	"skyblue":              color.NRGBA{0x87, 0xce, 0xeb, 0xff},
+	"slateblue":            color.NRGBA{0x6a, 0x5a, 0xcd, 0xff},
+	"slategray":            color.NRGBA{0x70, 0x80, 0x90, 0xff},
+	"slategrey":            color.NRGBA{0x70, 0x80, 0x90, 0xff},
+	"snow":                 color.NRGBA{0xff, 0xfa, 0xfa, 0xff},
+	"springgreen":          color.NRGBA{0x00, 0xff, 0x7f, 0xff},
+	"steelblue":            color.NRGBA{0x46, 0x82, 0xb4, 0xff},
+	"tan":                  color.NRGBA{0xd2, 0xb4, 0x8c, 0xff},
+	"teal":                 color.NRGBA{0x00, 0x80, 0x80, 0xff},
+	"thistle":              color.NRGBA{0xd8, 0xbf, 0xd8, 0xff},
+	"tomato":               color.NRGBA{0xff, 0x63, 0x47, 0xff},
+	"transparent":          color.NRGBA{0x00, 0x00, 0x00, 0x00},
+	"turquoise":            color.NRGBA{0x40, 0xe0, 0xd0, 0xff},
+	"violet":               color.NRGBA{0xee, 0x82, 0xee, 0xff},
+	"wheat":                color.NRGBA{0xf5, 0xde, 0xb3, 0xff},
+	"white":                color.NRGBA{0xff, 0xff, 0xff, 0xff},
+	"whitesmoke":           color.NRGBA{0xf5, 0xf5, 0xf5, 0xff},
+	"yellow":               color.NRGBA{0xff, 0xff, 0x00, 0xff},
+	"yellowgreen":          color.NRGBA{0x9a, 0xcd, 0x32, 0xff},
+}
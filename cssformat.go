@@ -0,0 +1,27 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncs
+
+import "fmt"
+
+// CSS returns a CSS Color Module Level 4 rgb() string for c, suitable for
+// use anywhere CSS accepts a color, e.g. "rgb(196 2 51)". It round-trips
+// through c's existing RGBA conversion, so it carries the same precision
+// as the rest of this package; parse it back with the ncs/css
+// subpackage's Parse and ncs.FromColor.
+func (c Color) CSS() string {
+	r, g, b, _ := c.RGBA()
+	return fmt.Sprintf("rgb(%d %d %d)", r>>8, g>>8, b>>8)
+}
@@ -0,0 +1,39 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncs_test
+
+import (
+	"testing"
+
+	. "github.com/hajimehoshi/ncs"
+)
+
+func TestColorCSS(t *testing.T) {
+	cases := []struct {
+		In   Color
+		Want string
+	}{
+		{In: Color{Blackness: 30, Chromaticness: 10, Hue: 10}, Want: "rgb(177 171 153)"},
+		{In: Color{Blackness: 0, Chromaticness: 0, Hue: 0}, Want: "rgb(255 255 255)"},
+		{In: Color{Blackness: 99, Chromaticness: 0, Hue: 0}, Want: "rgb(2 2 2)"},
+	}
+
+	for _, c := range cases {
+		got := c.In.CSS()
+		if got != c.Want {
+			t.Errorf("%v.CSS(): got %q, want %q", c.In, got, c.Want)
+		}
+	}
+}
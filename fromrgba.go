@@ -0,0 +1,178 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncs
+
+import (
+	"image/color"
+	"math"
+	"sync"
+)
+
+// lab is a color in the CIE 1976 L*a*b* color space, relative to the D65
+// white point.
+type lab struct {
+	L float64
+	A float64
+	B float64
+}
+
+// dist2 returns the squared Euclidean distance to o, i.e. the squared
+// CIE76 ΔE.
+func (c lab) dist2(o lab) float64 {
+	dl := c.L - o.L
+	da := c.A - o.A
+	db := c.B - o.B
+	return dl*dl + da*da + db*db
+}
+
+// d65 is the CIE 1931 XYZ tristimulus values of the D65 white point.
+const (
+	d65X = 0.95047
+	d65Y = 1.00000
+	d65Z = 1.08883
+)
+
+func srgbToLinear(c float64) float64 {
+	if c <= 0.04045 {
+		return c / 12.92
+	}
+	return math.Pow((c+0.055)/1.055, 2.4)
+}
+
+func labF(t float64) float64 {
+	const delta = 6.0 / 29.0
+	if t > delta*delta*delta {
+		return math.Cbrt(t)
+	}
+	return t/(3*delta*delta) + 4.0/29.0
+}
+
+// rgbaToLab converts an image/color.Color-style (r, g, b, a) in
+// [0, 0xffff] to CIE L*a*b*, via sRGB -> linear RGB -> CIE XYZ. a is
+// ignored and r, g and b are used as given; NCS notation has no concept
+// of transparency.
+func rgbaToLab(r, g, b, a uint32) lab {
+	rl := srgbToLinear(float64(r>>8) / 0xff)
+	gl := srgbToLinear(float64(g>>8) / 0xff)
+	bl := srgbToLinear(float64(b>>8) / 0xff)
+
+	x := rl*0.4124564 + gl*0.3575761 + bl*0.1804375
+	y := rl*0.2126729 + gl*0.7151522 + bl*0.0721750
+	z := rl*0.0193339 + gl*0.1191920 + bl*0.9503041
+
+	fx := labF(x / d65X)
+	fy := labF(y / d65Y)
+	fz := labF(z / d65Z)
+
+	return lab{
+		L: 116*fy - 16,
+		A: 500 * (fx - fy),
+		B: 200 * (fy - fz),
+	}
+}
+
+// achromaticThreshold is how close a* and b* must be to 0 for an input to
+// be treated as achromatic, avoiding an unstable hue pick for grays.
+const achromaticThreshold = 0.5
+
+// ncsSample is one point of the precomputed NCS -> Lab grid used by
+// FromRGBA.
+type ncsSample struct {
+	color Color
+	lab   lab
+}
+
+var (
+	ncsGrid     []ncsSample
+	ncsGridOnce sync.Once
+)
+
+// buildNCSGrid computes the Lab value of every well-formed chromatic
+// (Chromaticness 1 and up) NCS notation by
+// round-tripping through Color.RGBA. It is run once, lazily, since most
+// programs using this package never call FromRGBA.
+func buildNCSGrid() {
+	for b := 0; b <= 99; b++ {
+		maxC := 100 - b
+		if maxC > 99 {
+			maxC = 99
+		}
+		for c := 1; c <= maxC; c++ {
+			for h := 0; h < 400; h++ {
+				col := Color{Blackness: b, Chromaticness: c, Hue: h}
+				ncsGrid = append(ncsGrid, ncsSample{col, rgbaToLab(col.RGBA())})
+			}
+		}
+	}
+}
+
+// nearestChromatic returns the sample in ncsGrid whose Lab value is
+// closest to target under CIE76 ΔE.
+func nearestChromatic(target lab) Color {
+	best := Color{}
+	bestDist := math.MaxFloat64
+	for _, s := range ncsGrid {
+		if d := target.dist2(s.lab); d < bestDist {
+			bestDist = d
+			best = s.color
+		}
+	}
+	return best
+}
+
+// nearestAchromatic returns the N (Chromaticness 0) notation whose Lab
+// value is closest to target.
+func nearestAchromatic(target lab) Color {
+	best := Color{}
+	bestDist := math.MaxFloat64
+	for bl := 0; bl <= 99; bl++ {
+		c := Color{Blackness: bl}
+		if d := target.dist2(rgbaToLab(c.RGBA())); d < bestDist {
+			bestDist = d
+			best = c
+		}
+	}
+	return best
+}
+
+// FromRGBA returns the well-formed NCS Color that most closely
+// approximates the sRGB color (r, g, b), given as 16-bit values like
+// those returned by image/color.Color's RGBA method. a is accepted for
+// symmetry with that interface but does not affect the result, since NCS
+// notation has no concept of transparency; r, g and b are used as given,
+// so for a translucent color, whose RGBA values are alpha-premultiplied,
+// the match is for the premultiplied color.
+//
+// The match is found by converting to CIE L*a*b* (D65) and picking the
+// closest point, by CIE76 ΔE, from a precomputed grid of every
+// well-formed chromatic NCS notation. Inputs with near-zero chroma are snapped to
+// Chromaticness 0 and matched on lightness alone, since hue is unstable
+// for grays. The search is brute-force; it is exposed as a single
+// function so the underlying index can be swapped for something faster,
+// such as a k-d tree, without changing the API.
+func FromRGBA(r, g, b, a uint32) Color {
+	target := rgbaToLab(r, g, b, a)
+	if math.Abs(target.A) < achromaticThreshold && math.Abs(target.B) < achromaticThreshold {
+		return nearestAchromatic(target)
+	}
+	ncsGridOnce.Do(buildNCSGrid)
+	return nearestChromatic(target)
+}
+
+// FromColor is a convenience wrapper around FromRGBA for values
+// implementing image/color.Color, e.g. pixels read from an image.Image.
+func FromColor(c color.Color) Color {
+	return FromRGBA(c.RGBA())
+}
@@ -0,0 +1,48 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncs_test
+
+import (
+	"image/color"
+	"testing"
+
+	. "github.com/hajimehoshi/ncs"
+)
+
+func TestFromRGBA(t *testing.T) {
+	cases := []struct {
+		In   Color
+		Want Color
+	}{
+		{In: Color{Blackness: 30, Chromaticness: 10, Hue: 10}},
+		{In: Color{Blackness: 0, Chromaticness: 0, Hue: 0}},
+		{In: Color{Blackness: 99, Chromaticness: 0, Hue: 0}},
+		{In: Color{Blackness: 40, Chromaticness: 20, Hue: 200}},
+	}
+
+	for _, c := range cases {
+		got := FromRGBA(c.In.RGBA())
+		if got != c.In {
+			t.Errorf("FromRGBA(%v.RGBA()): got %v, want %v", c.In, got, c.In)
+		}
+	}
+}
+
+func TestFromColor(t *testing.T) {
+	got := FromColor(color.Gray{Y: 0x70})
+	if got.Chromaticness != 0 {
+		t.Errorf("FromColor(gray): got Chromaticness %d, want 0", got.Chromaticness)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncs
+
+// hueDistance is the number of wheel steps to go from h0 to h1 the short
+// way around the 400-step Hue wheel, in [-200, 200].
+func hueDistance(h0, h1 int) int {
+	d := (h1 - h0) % 400
+	if d > 200 {
+		d -= 400
+	}
+	if d < -200 {
+		d += 400
+	}
+	return d
+}
+
+// normalizeHue brings h into [0, 400).
+func normalizeHue(h int) int {
+	h %= 400
+	if h < 0 {
+		h += 400
+	}
+	return h
+}
+
+// Interpolate returns the Color t of the way from a to b, t in [0, 1],
+// walking Blackness and Chromaticness linearly and Hue the short way
+// around the 0-400 wheel, so the result is itself a plausible NCS
+// notation rather than an RGB blend.
+func Interpolate(a, b Color, t float64) Color {
+	bl := a.Blackness + round(float64(b.Blackness-a.Blackness)*t)
+	ch := a.Chromaticness + round(float64(b.Chromaticness-a.Chromaticness)*t)
+	h := a.Hue
+	if ch != 0 {
+		h = normalizeHue(a.Hue + round(float64(hueDistance(a.Hue, b.Hue))*t))
+	} else {
+		h = 0
+	}
+	return Color{Blackness: bl, Chromaticness: ch, Hue: h}
+}
+
+// Palette returns n Colors evenly spaced between a and b inclusive, via
+// Interpolate. Palette panics if n < 2.
+func Palette(a, b Color, n int) []Color {
+	if n < 2 {
+		panic("ncs: Palette: n must be at least 2")
+	}
+	cs := make([]Color, n)
+	for i := 0; i < n; i++ {
+		cs[i] = Interpolate(a, b, float64(i)/float64(n-1))
+	}
+	return cs
+}
+
+// Complementary returns the color opposite c on the Hue wheel, i.e. with
+// Hue shifted by 200 (half of 400), and the same Blackness and
+// Chromaticness.
+func Complementary(c Color) Color {
+	return hueShifted(c, 200)
+}
+
+// Triadic returns the two colors evenly spaced from c around the Hue
+// wheel, 400/3 steps apart, forming a triad with c.
+func Triadic(c Color) []Color {
+	return []Color{hueShifted(c, 400/3), hueShifted(c, 2*400/3)}
+}
+
+// Tetradic returns the three colors evenly spaced from c around the Hue
+// wheel, 100 steps apart, forming a tetrad with c.
+func Tetradic(c Color) []Color {
+	return []Color{hueShifted(c, 100), hueShifted(c, 200), hueShifted(c, 300)}
+}
+
+// Analogous returns n colors neighboring c on the Hue wheel, step apart,
+// centered on c: for n colors it spans from -((n-1)/2)*step to
+// +(n/2)*step, so Analogous(c, step, 1) is []Color{c}.
+func Analogous(c Color, step, n int) []Color {
+	cs := make([]Color, n)
+	start := -(n - 1) / 2
+	for i := 0; i < n; i++ {
+		cs[i] = hueShifted(c, (start+i)*step)
+	}
+	return cs
+}
+
+// hueShifted returns c with its Hue shifted by delta steps around the
+// wheel; achromatic colors are returned unchanged, since their Hue is
+// always 0.
+func hueShifted(c Color, delta int) Color {
+	if c.Chromaticness == 0 {
+		return c
+	}
+	c.Hue = normalizeHue(c.Hue + delta)
+	return c
+}
+
+// Neighbors returns every well-formed NCS notation within radius steps
+// of c in each of Blackness, Chromaticness and Hue, useful for building
+// color-picker swatches around a chosen color. c itself is not included.
+func Neighbors(c Color, radius int) []Color {
+	var cs []Color
+	for bl := c.Blackness - radius; bl <= c.Blackness+radius; bl++ {
+		if bl < 0 || bl > 99 {
+			continue
+		}
+		maxC := 100 - bl
+		if maxC > 99 {
+			maxC = 99
+		}
+		for ch := c.Chromaticness - radius; ch <= c.Chromaticness+radius; ch++ {
+			if ch < 0 || ch > maxC {
+				continue
+			}
+			if ch == 0 {
+				if bl == c.Blackness && ch == c.Chromaticness {
+					continue
+				}
+				cs = append(cs, Color{Blackness: bl})
+				continue
+			}
+			for dh := -radius; dh <= radius; dh++ {
+				h := normalizeHue(c.Hue + dh)
+				n := Color{Blackness: bl, Chromaticness: ch, Hue: h}
+				if n == c {
+					continue
+				}
+				cs = append(cs, n)
+			}
+		}
+	}
+	return cs
+}
+
+func round(f float64) int {
+	if f < 0 {
+		return int(f - 0.5)
+	}
+	return int(f + 0.5)
+}
@@ -0,0 +1,129 @@
+// Copyright 2018 Hajime Hoshi
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ncs_test
+
+import (
+	"testing"
+
+	. "github.com/hajimehoshi/ncs"
+)
+
+func TestInterpolate(t *testing.T) {
+	a := Color{Blackness: 10, Chromaticness: 20, Hue: 10}
+	b := Color{Blackness: 30, Chromaticness: 40, Hue: 30}
+
+	if got := Interpolate(a, b, 0); got != a {
+		t.Errorf("Interpolate(a, b, 0): got %v, want %v", got, a)
+	}
+	if got := Interpolate(a, b, 1); got != b {
+		t.Errorf("Interpolate(a, b, 1): got %v, want %v", got, b)
+	}
+	want := Color{Blackness: 20, Chromaticness: 30, Hue: 20}
+	if got := Interpolate(a, b, 0.5); got != want {
+		t.Errorf("Interpolate(a, b, 0.5): got %v, want %v", got, want)
+	}
+}
+
+func TestInterpolateHueWraps(t *testing.T) {
+	a := Color{Blackness: 10, Chromaticness: 20, Hue: 390}
+	b := Color{Blackness: 10, Chromaticness: 20, Hue: 10}
+	want := Color{Blackness: 10, Chromaticness: 20, Hue: 0}
+	if got := Interpolate(a, b, 0.5); got != want {
+		t.Errorf("Interpolate(a, b, 0.5): got %v, want %v", got, want)
+	}
+}
+
+func TestPalette(t *testing.T) {
+	a := Color{Blackness: 0, Chromaticness: 0, Hue: 0}
+	b := Color{Blackness: 100 - 1, Chromaticness: 0, Hue: 0}
+	cs := Palette(a, b, 3)
+	if len(cs) != 3 {
+		t.Fatalf("Palette: got %d colors, want 3", len(cs))
+	}
+	if cs[0] != a {
+		t.Errorf("Palette[0]: got %v, want %v", cs[0], a)
+	}
+	if cs[2] != b {
+		t.Errorf("Palette[2]: got %v, want %v", cs[2], b)
+	}
+}
+
+func TestComplementary(t *testing.T) {
+	c := Color{Blackness: 10, Chromaticness: 20, Hue: 50}
+	want := Color{Blackness: 10, Chromaticness: 20, Hue: 250}
+	if got := Complementary(c); got != want {
+		t.Errorf("Complementary(%v): got %v, want %v", c, got, want)
+	}
+
+	gray := Color{Blackness: 50, Chromaticness: 0, Hue: 0}
+	if got := Complementary(gray); got != gray {
+		t.Errorf("Complementary(gray): got %v, want unchanged %v", got, gray)
+	}
+}
+
+func TestTriadicAndTetradic(t *testing.T) {
+	c := Color{Blackness: 10, Chromaticness: 20, Hue: 0}
+
+	tri := Triadic(c)
+	if len(tri) != 2 {
+		t.Fatalf("Triadic: got %d colors, want 2", len(tri))
+	}
+	for _, h := range []int{tri[0].Hue, tri[1].Hue} {
+		if h != 133 && h != 266 {
+			t.Errorf("Triadic(%v): got Hue %d, want 133 or 266", c, h)
+		}
+	}
+
+	tet := Tetradic(c)
+	if len(tet) != 3 {
+		t.Fatalf("Tetradic: got %d colors, want 3", len(tet))
+	}
+	wantHues := []int{100, 200, 300}
+	for i, c2 := range tet {
+		if c2.Hue != wantHues[i] {
+			t.Errorf("Tetradic(%v)[%d]: got Hue %d, want %d", c, i, c2.Hue, wantHues[i])
+		}
+	}
+}
+
+func TestAnalogous(t *testing.T) {
+	c := Color{Blackness: 10, Chromaticness: 20, Hue: 100}
+	cs := Analogous(c, 10, 3)
+	want := []int{90, 100, 110}
+	if len(cs) != len(want) {
+		t.Fatalf("Analogous: got %d colors, want %d", len(cs), len(want))
+	}
+	for i, h := range want {
+		if cs[i].Hue != h {
+			t.Errorf("Analogous(%v)[%d]: got Hue %d, want %d", c, i, cs[i].Hue, h)
+		}
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	c := Color{Blackness: 50, Chromaticness: 50, Hue: 50}
+	ns := Neighbors(c, 1)
+	if len(ns) == 0 {
+		t.Fatal("Neighbors: got no neighbors")
+	}
+	for _, n := range ns {
+		if n == c {
+			t.Errorf("Neighbors(%v, 1): result includes c itself", c)
+		}
+		if n.Blackness < 49 || n.Blackness > 51 {
+			t.Errorf("Neighbors(%v, 1): got Blackness %d out of radius", c, n.Blackness)
+		}
+	}
+}